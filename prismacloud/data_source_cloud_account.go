@@ -0,0 +1,314 @@
+package prismacloud
+
+import (
+	"fmt"
+	"log"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceCloudAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cloud type, used with account_id to look up an account that was not onboarded by name",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cloud-specific account ID, must be used together with cloud_type",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account name, as registered on the Prisma Cloud platform (used in place of cloud_type/account_id)",
+			},
+
+			// Output.
+			account.TypeAws: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "AWS account type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "AWS account ID",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether or not the account is enabled",
+						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "AWS account external ID",
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of account IDs to which this account is assigned",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name to be used for the account on the Prisma Cloud platform",
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier for an AWS resource (ARN)",
+						},
+					},
+				},
+			},
+			account.TypeAzure: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Azure account type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Azure account ID",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether or not the account is enabled",
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of account IDs to which this account is assigned",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name to be used for the account on the Prisma Cloud platform",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application ID registered with Active Directory",
+						},
+						"monitor_flow_logs": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Automatically ingest flow logs",
+						},
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Active Directory ID associated with Azure",
+						},
+						"service_principal_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique ID of the service principal object associated with the Prisma Cloud application that you create",
+						},
+					},
+				},
+			},
+			account.TypeGcp: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "GCP account type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "GCP project ID",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether or not the account is enabled",
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of account IDs to which this account is assigned",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name to be used for the account on the Prisma Cloud platform",
+						},
+						"compression_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Enable flow log compression",
+						},
+						"dataflow_enabled_project": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "GCP project for flow log compression",
+						},
+						"flow_log_storage_bucket": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "GCP flow logs storage bucket",
+						},
+					},
+				},
+			},
+			account.TypeAlibaba: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Alibaba account type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alibaba account ID",
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of account IDs to which this account is assigned",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name to be used for the account on the Prisma Cloud platform",
+						},
+						"ram_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier for an Alibaba RAM role resource",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// saveDataSourceCloudAccount writes obj into the single cloudType block that
+// this data source's schema exposes. Unlike the resource's saveCloudAccount,
+// this only sets fields the data source's Computed-only schema declares, and
+// omits secrets (Azure's "key", GCP's "credentials_json") entirely.
+func saveDataSourceCloudAccount(d *schema.ResourceData, dest string, obj interface{}) {
+	var val map[string]interface{}
+
+	switch v := obj.(type) {
+	case account.Aws:
+		val = map[string]interface{}{
+			"account_id":  v.AccountId,
+			"enabled":     v.Enabled,
+			"external_id": v.ExternalId,
+			"group_ids":   v.GroupIds,
+			"name":        v.Name,
+			"role_arn":    v.RoleArn,
+		}
+	case account.Azure:
+		val = map[string]interface{}{
+			"account_id":           v.Account.AccountId,
+			"enabled":              v.Account.Enabled,
+			"group_ids":            v.Account.GroupIds,
+			"name":                 v.Account.Name,
+			"client_id":            v.ClientId,
+			"monitor_flow_logs":    v.MonitorFlowLogs,
+			"tenant_id":            v.TenantId,
+			"service_principal_id": v.ServicePrincipalId,
+		}
+	case account.Gcp:
+		val = map[string]interface{}{
+			"account_id":               v.Account.AccountId,
+			"enabled":                  v.Account.Enabled,
+			"group_ids":                v.Account.GroupIds,
+			"name":                     v.Account.Name,
+			"compression_enabled":      v.CompressionEnabled,
+			"dataflow_enabled_project": v.DataflowEnabledProject,
+			"flow_log_storage_bucket":  v.FlowLogStorageBucket,
+		}
+	case account.Alibaba:
+		val = map[string]interface{}{
+			"account_id": v.AccountId,
+			"group_ids":  v.GroupIds,
+			"name":       v.Name,
+			"ram_arn":    v.RamArn,
+		}
+	}
+
+	for _, key := range []string{account.TypeAws, account.TypeAzure, account.TypeGcp, account.TypeAlibaba} {
+		if key != dest {
+			d.Set(key, nil)
+			continue
+		}
+
+		if err := d.Set(key, []interface{}{val}); err != nil {
+			log.Printf("[WARN] Error setting %q field for %q: %s", key, d.Id(), err)
+		}
+	}
+}
+
+func dataSourceCloudAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	var (
+		cloudType string
+		id        string
+		err       error
+	)
+
+	name := d.Get("name").(string)
+	cloudType = d.Get("cloud_type").(string)
+	accountId := d.Get("account_id").(string)
+
+	switch {
+	case name != "":
+		for _, ct := range []string{account.TypeAws, account.TypeAzure, account.TypeGcp, account.TypeAlibaba} {
+			id, err = account.Identify(client, ct, name)
+			if err == nil {
+				cloudType = ct
+				break
+			}
+			if err != pc.ObjectNotFoundError {
+				return err
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("no cloud account named %q was found", name)
+		}
+	case cloudType != "" && accountId != "":
+		id = accountId
+	default:
+		return fmt.Errorf("either 'name' or both 'cloud_type' and 'account_id' must be specified")
+	}
+
+	obj, err := account.Get(client, cloudType, id)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(cloudType, id))
+	saveDataSourceCloudAccount(d, cloudType, obj)
+
+	return nil
+}
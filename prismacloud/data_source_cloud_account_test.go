@@ -0,0 +1,54 @@
+package prismacloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+)
+
+func TestDataSourceCloudAccountSchema(t *testing.T) {
+	if err := dataSourceCloudAccount().InternalValidate(nil, false); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
+
+func TestSaveDataSourceCloudAccountOmitsSecrets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceCloudAccount().Schema, map[string]interface{}{})
+
+	saveDataSourceCloudAccount(d, account.TypeAzure, account.Azure{
+		Account: account.CloudAccount{
+			AccountId: "azure-1",
+			Name:      "azure-account",
+		},
+		ClientId: "client-1",
+		Key:      "super-secret-key",
+	})
+
+	azureList := d.Get(account.TypeAzure).([]interface{})
+	if len(azureList) != 1 {
+		t.Fatalf("expected one azure block, got %d", len(azureList))
+	}
+	azure := azureList[0].(map[string]interface{})
+	if _, ok := azure["key"]; ok {
+		t.Errorf("expected 'key' to be omitted from the azure data source block, found %v", azure["key"])
+	}
+
+	saveDataSourceCloudAccount(d, account.TypeGcp, account.Gcp{
+		Account: account.CloudAccount{
+			AccountId: "gcp-1",
+			Name:      "gcp-account",
+		},
+		Credentials: account.GcpCredentials{PrivateKey: "super-secret-key"},
+	})
+
+	gcpList := d.Get(account.TypeGcp).([]interface{})
+	if len(gcpList) != 1 {
+		t.Fatalf("expected one gcp block, got %d", len(gcpList))
+	}
+	gcp := gcpList[0].(map[string]interface{})
+	if _, ok := gcp["credentials_json"]; ok {
+		t.Errorf("expected 'credentials_json' to be omitted from the gcp data source block, found %v", gcp["credentials_json"])
+	}
+}
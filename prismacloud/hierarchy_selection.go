@@ -0,0 +1,91 @@
+package prismacloud
+
+import (
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account/org"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Account onboarding types. The AWS, Azure, and GCP resources default to
+// accountTypeAccount; the other values route create/read/update/delete
+// through the account/org package instead of account. The Alibaba resource
+// has no account_type field.
+const (
+	accountTypeAccount              = "account"
+	accountTypeOrganization         = "organization"
+	accountTypeTenant               = "tenant"
+	accountTypeMasterServiceAccount = "masterServiceAccount"
+)
+
+// hierarchySelectionSchema returns the nested block used to scope an
+// organization/tenant level onboarding to a subset of its hierarchy.
+func hierarchySelectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Nodes of the cloud hierarchy to include in (or exclude from) this onboarding",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Identifier of the organizational unit, folder, or account node",
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Display name of the node",
+				},
+				"node_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of the node (ORG, OU, FOLDER, or ACCOUNT)",
+				},
+				"selection_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Whether to include, exclude, or onboard all descendants of the node (INCLUDE, EXCLUDE, or ALL)",
+				},
+			},
+		},
+	}
+}
+
+func memberAccountCountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of member accounts discovered under this organization/tenant. The SDK does not expose their identities, so this is a count only; enumerate the actual children with the cloud provider's own API/CLI (or the prismacloud_cloud_account data source once you know an ID) rather than trying to iterate over this attribute.",
+	}
+}
+
+func parseHierarchySelection(list []interface{}) []org.HierarchySelection {
+	ans := make([]org.HierarchySelection, 0, len(list))
+
+	for _, i := range list {
+		x := i.(map[string]interface{})
+		ans = append(ans, org.HierarchySelection{
+			ResourceId:    x["resource_id"].(string),
+			DisplayName:   x["display_name"].(string),
+			NodeType:      x["node_type"].(string),
+			SelectionType: x["selection_type"].(string),
+		})
+	}
+
+	return ans
+}
+
+func saveHierarchySelection(list []org.HierarchySelection) []interface{} {
+	ans := make([]interface{}, 0, len(list))
+
+	for _, x := range list {
+		ans = append(ans, map[string]interface{}{
+			"resource_id":    x.ResourceId,
+			"display_name":   x.DisplayName,
+			"node_type":      x.NodeType,
+			"selection_type": x.SelectionType,
+		})
+	}
+
+	return ans
+}
@@ -0,0 +1,113 @@
+package prismacloud
+
+import (
+	"fmt"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// accountTypeFromGet extracts the accountType field from the object returned
+// by account.Get. This works even when id belongs to an org-level onboarding:
+// the plain and org-level REST endpoints for a given cloud share the same
+// cloud/{cloudType}/{id} path, and the org structs (org.AwsOrg, and the
+// cloudAccount-nested account type on org.AzureOrg/org.GcpOrg) are
+// JSON-compatible supersets of their plain counterparts, so account.Get
+// still unmarshals the accountType field correctly. Alibaba has no org-level
+// concept, so it isn't one of the cases below and always reports
+// accountTypeAccount.
+func accountTypeFromGet(obj interface{}) string {
+	switch v := obj.(type) {
+	case account.Aws:
+		return v.AccountType
+	case account.Azure:
+		return v.Account.AccountType
+	case account.Gcp:
+		return v.Account.AccountType
+	default:
+		return accountTypeAccount
+	}
+}
+
+// cloudAccountImporter builds an importer for a single-cloud resource that
+// accepts either "cloudType:id" (the historical TwoStringsToId format) or
+// "cloudType:name", resolving a name to its account ID via account.Identify.
+// It also looks up and sets account_type, so a freshly imported resource
+// routes Read/Update/Delete through the correct account/account-org package
+// instead of defaulting to a plain account.
+func cloudAccountImporter(cloudType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client := meta.(*pc.Client)
+
+			ct, value := IdToTwoStrings(d.Id())
+			if ct != cloudType {
+				return nil, fmt.Errorf("import id must be in the form %q:id or %q:name", cloudType, cloudType)
+			}
+
+			obj, err := account.Get(client, cloudType, value)
+			if err != nil {
+				if err != pc.ObjectNotFoundError {
+					return nil, err
+				}
+
+				id, err := account.Identify(client, cloudType, value)
+				if err != nil {
+					return nil, err
+				}
+				value = id
+
+				if obj, err = account.Get(client, cloudType, value); err != nil {
+					return nil, err
+				}
+			}
+
+			if cloudType != account.TypeAlibaba {
+				if err := d.Set("account_type", accountTypeFromGet(obj)); err != nil {
+					return nil, err
+				}
+			}
+
+			d.SetId(TwoStringsToId(cloudType, value))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// cloudAccountImporterAny builds an importer for the deprecated polymorphic
+// resource, which can hold any of the supported cloud types. It behaves like
+// cloudAccountImporter, but the cloud type is taken from the import ID itself
+// rather than fixed ahead of time, and no account_type is set (this resource
+// has no such field).
+func cloudAccountImporterAny() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client := meta.(*pc.Client)
+
+			cloudType, value := IdToTwoStrings(d.Id())
+			switch cloudType {
+			case account.TypeAws, account.TypeAzure, account.TypeGcp, account.TypeAlibaba:
+			default:
+				return nil, fmt.Errorf("import id must be in the form cloudType:id or cloudType:name, where cloudType is one of %q, %q, %q, or %q",
+					account.TypeAws, account.TypeAzure, account.TypeGcp, account.TypeAlibaba)
+			}
+
+			if _, err := account.Get(client, cloudType, value); err != nil {
+				if err != pc.ObjectNotFoundError {
+					return nil, err
+				}
+
+				id, err := account.Identify(client, cloudType, value)
+				if err != nil {
+					return nil, err
+				}
+				value = id
+			}
+
+			d.SetId(TwoStringsToId(cloudType, value))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// newImporterTestClient starts an httptest server driven by handler and
+// returns a logged-in client pointed at it. handler must itself answer the
+// POST /login request Initialize sends.
+func newImporterTestClient(t *testing.T, handler http.HandlerFunc) (*pc.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+
+	client := &pc.Client{
+		Protocol: "http",
+		Url:      strings.TrimPrefix(server.URL, "http://"),
+		Username: "test",
+		Password: "test",
+		Logging:  map[string]bool{},
+	}
+
+	if err := client.Initialize(""); err != nil {
+		server.Close()
+		t.Fatalf("failed to initialize test client: %s", err)
+	}
+
+	return client, server
+}
+
+func notFoundHandler(w http.ResponseWriter) {
+	b, _ := json.Marshal([]map[string]string{{"i18nKey": "not_found", "severity": "error"}})
+	w.Header().Set("X-Redlock-Status", string(b))
+	w.WriteHeader(http.StatusTeapot)
+}
+
+func TestCloudAccountImporterRejectsMismatchedCloudType(t *testing.T) {
+	imp := cloudAccountImporter(account.TypeAws)
+	d := &schema.ResourceData{}
+	d.SetId(TwoStringsToId(account.TypeAzure, "some-id"))
+
+	if _, err := imp.State(d, &pc.Client{}); err == nil {
+		t.Fatal("expected an error for a mismatched cloud type, got none")
+	}
+}
+
+func TestCloudAccountImporterSetsAccountTypeForOrgAccount(t *testing.T) {
+	client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+		case r.URL.Path == "/cloud/aws/org-id-1":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"accountId":   "org-id-1",
+				"name":        "my-org",
+				"accountType": accountTypeOrganization,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, map[string]interface{}{})
+	d.SetId(TwoStringsToId(account.TypeAws, "org-id-1"))
+
+	imp := cloudAccountImporter(account.TypeAws)
+	results, err := imp.State(d, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	if got := results[0].Get("account_type").(string); got != accountTypeOrganization {
+		t.Errorf("expected account_type %q, got %q", accountTypeOrganization, got)
+	}
+}
+
+func TestCloudAccountImporterResolvesNameToId(t *testing.T) {
+	client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+		case r.URL.Path == "/cloud/aws/my-prod-account":
+			notFoundHandler(w)
+		case r.URL.Path == "/cloud/name":
+			json.NewEncoder(w).Encode([]map[string]string{
+				{"name": "my-prod-account", "cloudType": account.TypeAws, "id": "resolved-id"},
+			})
+		case r.URL.Path == "/cloud/aws/resolved-id":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"accountId":   "resolved-id",
+				"name":        "my-prod-account",
+				"accountType": accountTypeAccount,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, map[string]interface{}{})
+	d.SetId(TwoStringsToId(account.TypeAws, "my-prod-account"))
+
+	imp := cloudAccountImporter(account.TypeAws)
+	results, err := imp.State(d, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, id := IdToTwoStrings(results[0].Id()); id != "resolved-id" {
+		t.Errorf("expected resolved id %q, got %q", "resolved-id", id)
+	}
+	if got := results[0].Get("account_type").(string); got != accountTypeAccount {
+		t.Errorf("expected account_type %q, got %q", accountTypeAccount, got)
+	}
+}
+
+func TestAccountTypeFromGet(t *testing.T) {
+	cases := []struct {
+		name string
+		obj  interface{}
+		want string
+	}{
+		{"aws", account.Aws{AccountType: accountTypeOrganization}, accountTypeOrganization},
+		{"azure", account.Azure{Account: account.CloudAccount{AccountType: accountTypeTenant}}, accountTypeTenant},
+		{"gcp", account.Gcp{Account: account.CloudAccount{AccountType: accountTypeOrganization}}, accountTypeOrganization},
+		{"alibaba has no org concept", account.Alibaba{}, accountTypeAccount},
+	}
+
+	for _, c := range cases {
+		if got := accountTypeFromGet(c.obj); got != c.want {
+			t.Errorf("%s: expected %q, got %q", c.name, c.want, got)
+		}
+	}
+}
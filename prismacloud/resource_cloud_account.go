@@ -18,17 +18,26 @@ func resourceCloudAccount() *schema.Resource {
 		Update: updateCloudAccount,
 		Delete: deleteCloudAccount,
 
+		DeprecationMessage: "This resource is deprecated in favor of the per-cloud " +
+			"prismacloud_cloud_account_aws, prismacloud_cloud_account_azure, " +
+			"prismacloud_cloud_account_gcp, and prismacloud_cloud_account_alibaba resources, " +
+			"and will be removed in a future release.",
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: cloudAccountImporterAny(),
 
 		Schema: map[string]*schema.Schema{
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip waiting for the account's onboarding validation to complete",
+			},
+
 			// AWS type.
 			account.TypeAws: {
 				Type:        schema.TypeList,
@@ -405,6 +414,13 @@ func createCloudAccount(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(TwoStringsToId(cloudType, id))
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, cloudType, id, false, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return readCloudAccount(d, meta)
 }
 
@@ -429,13 +445,19 @@ func readCloudAccount(d *schema.ResourceData, meta interface{}) error {
 func updateCloudAccount(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*pc.Client)
 
-	_, id := IdToTwoStrings(d.Id())
+	cloudType, id := IdToTwoStrings(d.Id())
 	_, _, obj := parseCloudAccount(d, id)
 
 	if err := account.Update(client, obj); err != nil {
 		return err
 	}
 
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, cloudType, id, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return readCloudAccount(d, meta)
 }
 
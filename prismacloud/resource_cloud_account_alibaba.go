@@ -0,0 +1,172 @@
+package prismacloud
+
+import (
+	"log"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceCloudAccountAlibaba() *schema.Resource {
+	return &schema.Resource{
+		Create: createCloudAccountAlibaba,
+		Read:   readCloudAccountAlibaba,
+		Update: updateCloudAccountAlibaba,
+		Delete: deleteCloudAccountAlibaba,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: cloudAccountImporter(account.TypeAlibaba),
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Alibaba account ID",
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of account IDs to which you are assigning this account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				Description:   "Name to be used for the account on the Prisma Cloud platform (must be unique)",
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "Creates a unique name beginning with the specified prefix",
+			},
+			"ram_arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for an Alibaba RAM role resource",
+			},
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip waiting for the account's onboarding validation to complete",
+			},
+		},
+	}
+}
+
+func parseCloudAccountAlibaba(d *schema.ResourceData, id string) account.Alibaba {
+	return account.Alibaba{
+		AccountId: id,
+		GroupIds:  ListToStringSlice(d.Get("group_ids").([]interface{})),
+		Name:      d.Get("name").(string),
+		RamArn:    d.Get("ram_arn").(string),
+	}
+}
+
+func saveCloudAccountAlibaba(d *schema.ResourceData, obj account.Alibaba) {
+	d.Set("account_id", obj.AccountId)
+	if err := d.Set("group_ids", obj.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Name)
+	d.Set("ram_arn", obj.RamArn)
+}
+
+func createCloudAccountAlibaba(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	if d.Get("name").(string) == "" {
+		if v, ok := d.GetOk("name_prefix"); ok {
+			d.Set("name", resource.PrefixedUniqueId(v.(string)))
+		} else {
+			d.Set("name", resource.UniqueId())
+		}
+	}
+
+	obj := parseCloudAccountAlibaba(d, "")
+
+	if err := account.Create(client, obj); err != nil {
+		return err
+	}
+
+	id, err := account.Identify(client, account.TypeAlibaba, obj.Name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(account.TypeAlibaba, id))
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAlibaba, id, false, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAlibaba(d, meta)
+}
+
+func readCloudAccountAlibaba(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	obj, err := account.Get(client, account.TypeAlibaba, id)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveCloudAccountAlibaba(d, obj.(account.Alibaba))
+
+	return nil
+}
+
+func updateCloudAccountAlibaba(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	_, id := IdToTwoStrings(d.Id())
+	obj := parseCloudAccountAlibaba(d, id)
+
+	if err := account.Update(client, obj); err != nil {
+		return err
+	}
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAlibaba, id, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAlibaba(d, meta)
+}
+
+func deleteCloudAccountAlibaba(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	err := account.Delete(client, account.TypeAlibaba, id)
+	if err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,11 @@
+package prismacloud
+
+import (
+	"testing"
+)
+
+func TestResourceCloudAccountAlibabaSchema(t *testing.T) {
+	if err := resourceCloudAccountAlibaba().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
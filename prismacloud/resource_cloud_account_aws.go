@@ -0,0 +1,310 @@
+package prismacloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account/org"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceCloudAccountAws() *schema.Resource {
+	return &schema.Resource{
+		Create: createCloudAccountAws,
+		Read:   readCloudAccountAws,
+		Update: updateCloudAccountAws,
+		Delete: deleteCloudAccountAws,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: cloudAccountImporter(account.TypeAws),
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS account ID",
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     accountTypeAccount,
+				Description: "Account onboarding type: account or organization",
+			},
+			"hierarchy_selection": hierarchySelectionSchema(),
+			"member_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IAM role name assumed in each member account of the organization, required when account_type is organization",
+			},
+			"member_external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID used when assuming the member role in each member account",
+				Sensitive:   true,
+			},
+			"member_account_count": memberAccountCountSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not the account is enabled",
+				Default:     true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS account external ID",
+				Sensitive:   true,
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of account IDs to which you are assigning this account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				Description:   "Name to be used for the account on the Prisma Cloud platform (must be unique)",
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "Creates a unique name beginning with the specified prefix",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for an AWS resource (ARN)",
+			},
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip waiting for the account's onboarding validation to complete",
+			},
+		},
+	}
+}
+
+func isAwsOrgAccount(d *schema.ResourceData) bool {
+	return d.Get("account_type").(string) != accountTypeAccount
+}
+
+func parseCloudAccountAws(d *schema.ResourceData, id string) account.Aws {
+	return account.Aws{
+		AccountId:   id,
+		Enabled:     d.Get("enabled").(bool),
+		ExternalId:  d.Get("external_id").(string),
+		GroupIds:    ListToStringSlice(d.Get("group_ids").([]interface{})),
+		Name:        d.Get("name").(string),
+		RoleArn:     d.Get("role_arn").(string),
+		AccountType: d.Get("account_type").(string),
+	}
+}
+
+func parseCloudAccountAwsOrg(d *schema.ResourceData, id string) org.AwsOrg {
+	return org.AwsOrg{
+		AccountId:          id,
+		Enabled:            d.Get("enabled").(bool),
+		ExternalId:         d.Get("external_id").(string),
+		GroupIds:           ListToStringSlice(d.Get("group_ids").([]interface{})),
+		Name:               d.Get("name").(string),
+		RoleArn:            d.Get("role_arn").(string),
+		AccountType:        d.Get("account_type").(string),
+		MemberRoleName:     d.Get("member_role_name").(string),
+		MemberExternalId:   d.Get("member_external_id").(string),
+		HierarchySelection: parseHierarchySelection(d.Get("hierarchy_selection").([]interface{})),
+	}
+}
+
+func saveCloudAccountAws(d *schema.ResourceData, obj account.Aws) {
+	d.Set("account_id", obj.AccountId)
+	d.Set("enabled", obj.Enabled)
+	d.Set("external_id", obj.ExternalId)
+	if err := d.Set("group_ids", obj.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Name)
+	d.Set("role_arn", obj.RoleArn)
+	d.Set("account_type", obj.AccountType)
+}
+
+func saveCloudAccountAwsOrg(d *schema.ResourceData, obj org.AwsOrg, memberAccountCount int) {
+	d.Set("account_id", obj.AccountId)
+	d.Set("enabled", obj.Enabled)
+	d.Set("external_id", obj.ExternalId)
+	if err := d.Set("group_ids", obj.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Name)
+	d.Set("role_arn", obj.RoleArn)
+	d.Set("account_type", obj.AccountType)
+	d.Set("member_role_name", obj.MemberRoleName)
+	d.Set("member_external_id", obj.MemberExternalId)
+	d.Set("member_account_count", memberAccountCount)
+	if err := d.Set("hierarchy_selection", saveHierarchySelection(obj.HierarchySelection)); err != nil {
+		log.Printf("[WARN] Error setting 'hierarchy_selection' field for %q: %s", d.Id(), err)
+	}
+}
+
+func createCloudAccountAws(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	if d.Get("name").(string) == "" {
+		if v, ok := d.GetOk("name_prefix"); ok {
+			d.Set("name", resource.PrefixedUniqueId(v.(string)))
+		} else {
+			d.Set("name", resource.UniqueId())
+		}
+	}
+
+	isOrg := isAwsOrgAccount(d)
+
+	var (
+		name string
+		err  error
+	)
+
+	if isOrg {
+		obj := parseCloudAccountAwsOrg(d, "")
+		if obj.MemberRoleName == "" {
+			return fmt.Errorf("member_role_name is required when account_type is %q", obj.AccountType)
+		}
+		name = obj.Name
+		err = org.Create(client, obj)
+	} else {
+		obj := parseCloudAccountAws(d, "")
+		name = obj.Name
+		err = account.Create(client, obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	var id string
+	if isOrg {
+		id, err = org.Identify(client, org.TypeAwsOrg, name)
+	} else {
+		id, err = account.Identify(client, account.TypeAws, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(account.TypeAws, id))
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAws, id, isOrg, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAws(d, meta)
+}
+
+func readCloudAccountAws(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	if isAwsOrgAccount(d) {
+		obj, err := org.Get(client, org.TypeAwsOrg, id)
+		if err != nil {
+			if err == pc.ObjectNotFoundError {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+
+		count := 0
+		if list, err := org.List(client); err == nil {
+			for _, o := range list {
+				if o.CloudType == org.TypeAwsOrg && o.AccountId == id {
+					count = o.NumberOfChildAccounts
+					break
+				}
+			}
+		}
+
+		saveCloudAccountAwsOrg(d, obj.(org.AwsOrg), count)
+		return nil
+	}
+
+	obj, err := account.Get(client, account.TypeAws, id)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveCloudAccountAws(d, obj.(account.Aws))
+
+	return nil
+}
+
+func updateCloudAccountAws(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	_, id := IdToTwoStrings(d.Id())
+	isOrg := isAwsOrgAccount(d)
+
+	var err error
+	if isOrg {
+		obj := parseCloudAccountAwsOrg(d, id)
+		if obj.MemberRoleName == "" {
+			return fmt.Errorf("member_role_name is required when account_type is %q", obj.AccountType)
+		}
+		err = org.Update(client, obj)
+	} else {
+		err = account.Update(client, parseCloudAccountAws(d, id))
+	}
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAws, id, isOrg, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAws(d, meta)
+}
+
+func deleteCloudAccountAws(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	var err error
+	if isAwsOrgAccount(d) {
+		err = org.Delete(client, org.TypeAwsOrg, id)
+	} else {
+		err = account.Delete(client, account.TypeAws, id)
+	}
+	if err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,268 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+)
+
+func TestResourceCloudAccountAwsSchema(t *testing.T) {
+	if err := resourceCloudAccountAws().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
+
+func TestIsAwsOrgAccount(t *testing.T) {
+	cases := []struct {
+		accountType string
+		want        bool
+	}{
+		{accountTypeAccount, false},
+		{accountTypeOrganization, true},
+		{accountTypeMasterServiceAccount, true},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, map[string]interface{}{
+			"account_type": c.accountType,
+		})
+
+		if got := isAwsOrgAccount(d); got != c.want {
+			t.Errorf("account_type %q: expected %v, got %v", c.accountType, c.want, got)
+		}
+	}
+}
+
+// TestCreateCloudAccountAwsDispatch proves, via the request body actually
+// sent over the wire, that an organization account_type is routed through
+// org.Create (whose body carries org-only fields like memberRoleName) while
+// a plain account_type goes through account.Create (whose body does not).
+func TestCreateCloudAccountAwsDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		extra       map[string]interface{}
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, nil, false},
+		{"organization account", accountTypeOrganization, map[string]interface{}{"member_role_name": "org-role"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var createBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "POST" && r.URL.Path == "/cloud/aws":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &createBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/name":
+					json.NewEncoder(w).Encode([]map[string]string{
+						{"name": "my-account", "cloudType": account.TypeAws, "id": "acct-1"},
+					})
+				case r.URL.Path == "/cloud/aws/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"accountId":   "acct-1",
+						"name":        "my-account",
+						"accountType": c.accountType,
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			raw := map[string]interface{}{
+				"account_type":    c.accountType,
+				"name":            "my-account",
+				"external_id":     "ext-1",
+				"role_arn":        "arn:aws:iam::1:role/x",
+				"group_ids":       []interface{}{"g1"},
+				"skip_validation": true,
+			}
+			for k, v := range c.extra {
+				raw[k] = v
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, raw)
+
+			if err := createCloudAccountAws(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := createBody["memberRoleName"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected memberRoleName present=%v in create body, got %v", c.wantOrgBody, createBody)
+			}
+		})
+	}
+}
+
+// TestReadCloudAccountAwsDispatch proves that an org account_type reads
+// through org.Get (which decodes org-only fields like member_role_name)
+// while a plain account_type reads through account.Get (which does not).
+func TestReadCloudAccountAwsDispatch(t *testing.T) {
+	cases := []struct {
+		name            string
+		accountType     string
+		wantMemberRole  string
+		wantMemberCount int
+	}{
+		{"plain account", accountTypeAccount, "", 0},
+		{"organization account", accountTypeOrganization, "org-role", 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.URL.Path == "/cloud/aws/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"accountId":      "acct-1",
+						"name":           "my-account",
+						"accountType":    c.accountType,
+						"memberRoleName": "org-role",
+					})
+				case r.URL.Path == "/cloud":
+					json.NewEncoder(w).Encode([]map[string]interface{}{
+						{"cloudType": account.TypeAws, "accountId": "acct-1", "numberOfChildAccounts": c.wantMemberCount},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, map[string]interface{}{
+				"account_type": c.accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeAws, "acct-1"))
+
+			if err := readCloudAccountAws(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("member_role_name").(string); got != c.wantMemberRole {
+				t.Errorf("expected member_role_name %q, got %q", c.wantMemberRole, got)
+			}
+			if got := d.Get("member_account_count").(int); got != c.wantMemberCount {
+				t.Errorf("expected member_account_count %d, got %d", c.wantMemberCount, got)
+			}
+		})
+	}
+}
+
+// TestUpdateCloudAccountAwsDispatch mirrors TestCreateCloudAccountAwsDispatch
+// but for the update path (org.Update vs account.Update).
+func TestUpdateCloudAccountAwsDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		extra       map[string]interface{}
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, nil, false},
+		{"organization account", accountTypeOrganization, map[string]interface{}{"member_role_name": "org-role"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var updateBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "PUT" && r.URL.Path == "/cloud/aws/acct-1":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &updateBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/aws/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"accountId":   "acct-1",
+						"name":        "my-account",
+						"accountType": c.accountType,
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			raw := map[string]interface{}{
+				"account_type":    c.accountType,
+				"name":            "my-account",
+				"external_id":     "ext-1",
+				"role_arn":        "arn:aws:iam::1:role/x",
+				"group_ids":       []interface{}{"g1"},
+				"skip_validation": true,
+			}
+			for k, v := range c.extra {
+				raw[k] = v
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, raw)
+			d.SetId(TwoStringsToId(account.TypeAws, "acct-1"))
+
+			if err := updateCloudAccountAws(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := updateBody["memberRoleName"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected memberRoleName present=%v in update body, got %v", c.wantOrgBody, updateBody)
+			}
+		})
+	}
+}
+
+// TestDeleteCloudAccountAwsDispatch confirms deletion succeeds for both
+// account types. org.Delete and account.Delete issue an identical DELETE
+// to the same path with no body, so the wire cannot distinguish which one
+// ran; this only proves deleteCloudAccountAws doesn't error either way.
+func TestDeleteCloudAccountAwsDispatch(t *testing.T) {
+	for _, accountType := range []string{accountTypeAccount, accountTypeOrganization} {
+		t.Run(accountType, func(t *testing.T) {
+			var sawDelete bool
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "DELETE" && r.URL.Path == "/cloud/aws/acct-1":
+					sawDelete = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAws().Schema, map[string]interface{}{
+				"account_type": accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeAws, "acct-1"))
+
+			if err := deleteCloudAccountAws(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !sawDelete {
+				t.Error("expected a DELETE request to /cloud/aws/acct-1")
+			}
+			if d.Id() != "" {
+				t.Errorf("expected id to be cleared, got %q", d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package prismacloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account/org"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceCloudAccountAzure() *schema.Resource {
+	return &schema.Resource{
+		Create: createCloudAccountAzure,
+		Read:   readCloudAccountAzure,
+		Update: updateCloudAccountAzure,
+		Delete: deleteCloudAccountAzure,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: cloudAccountImporter(account.TypeAzure),
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Azure account ID",
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     accountTypeAccount,
+				Description: "Account onboarding type: account or tenant",
+			},
+			"hierarchy_selection":  hierarchySelectionSchema(),
+			"member_account_count": memberAccountCountSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not the account is enabled",
+				Default:     true,
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of account IDs to which you are assigning this account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				Description:   "Name to be used for the account on the Prisma Cloud platform (must be unique)",
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "Creates a unique name beginning with the specified prefix",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Application ID registered with Active Directory",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Application ID key",
+			},
+			"monitor_flow_logs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Automatically ingest flow logs",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Active Directory ID associated with Azure",
+			},
+			"service_principal_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique ID of the service principal object associated with the Prisma Cloud application that you create",
+			},
+			"root_sync_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Sync the entire Azure AD tenant hierarchy, required when account_type is tenant",
+			},
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip waiting for the account's onboarding validation to complete",
+			},
+		},
+	}
+}
+
+func isAzureOrgAccount(d *schema.ResourceData) bool {
+	return d.Get("account_type").(string) != accountTypeAccount
+}
+
+func parseCloudAccountAzure(d *schema.ResourceData, id string) account.Azure {
+	return account.Azure{
+		Account: account.CloudAccount{
+			AccountId:   id,
+			Enabled:     d.Get("enabled").(bool),
+			GroupIds:    ListToStringSlice(d.Get("group_ids").([]interface{})),
+			Name:        d.Get("name").(string),
+			AccountType: d.Get("account_type").(string),
+		},
+		ClientId:           d.Get("client_id").(string),
+		Key:                d.Get("key").(string),
+		MonitorFlowLogs:    d.Get("monitor_flow_logs").(bool),
+		TenantId:           d.Get("tenant_id").(string),
+		ServicePrincipalId: d.Get("service_principal_id").(string),
+	}
+}
+
+func parseCloudAccountAzureOrg(d *schema.ResourceData, id string) org.AzureOrg {
+	return org.AzureOrg{
+		Account: org.AzureCloudAccount{
+			AccountId:   id,
+			Enabled:     d.Get("enabled").(bool),
+			GroupIds:    ListToStringSlice(d.Get("group_ids").([]interface{})),
+			Name:        d.Get("name").(string),
+			AccountType: d.Get("account_type").(string),
+		},
+		ClientId:           d.Get("client_id").(string),
+		Key:                d.Get("key").(string),
+		MonitorFlowLogs:    d.Get("monitor_flow_logs").(bool),
+		TenantId:           d.Get("tenant_id").(string),
+		ServicePrincipalId: d.Get("service_principal_id").(string),
+		RootSyncEnabled:    d.Get("root_sync_enabled").(bool),
+		HierarchySelection: parseHierarchySelection(d.Get("hierarchy_selection").([]interface{})),
+	}
+}
+
+func saveCloudAccountAzure(d *schema.ResourceData, obj account.Azure) {
+	d.Set("account_id", obj.Account.AccountId)
+	d.Set("enabled", obj.Account.Enabled)
+	if err := d.Set("group_ids", obj.Account.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Account.Name)
+	d.Set("account_type", obj.Account.AccountType)
+	d.Set("client_id", obj.ClientId)
+	d.Set("key", obj.Key)
+	d.Set("monitor_flow_logs", obj.MonitorFlowLogs)
+	d.Set("tenant_id", obj.TenantId)
+	d.Set("service_principal_id", obj.ServicePrincipalId)
+}
+
+func saveCloudAccountAzureOrg(d *schema.ResourceData, obj org.AzureOrg, memberAccountCount int) {
+	d.Set("account_id", obj.Account.AccountId)
+	d.Set("enabled", obj.Account.Enabled)
+	if err := d.Set("group_ids", obj.Account.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Account.Name)
+	d.Set("account_type", obj.Account.AccountType)
+	d.Set("client_id", obj.ClientId)
+	d.Set("key", obj.Key)
+	d.Set("monitor_flow_logs", obj.MonitorFlowLogs)
+	d.Set("tenant_id", obj.TenantId)
+	d.Set("service_principal_id", obj.ServicePrincipalId)
+	d.Set("root_sync_enabled", obj.RootSyncEnabled)
+	d.Set("member_account_count", memberAccountCount)
+	if err := d.Set("hierarchy_selection", saveHierarchySelection(obj.HierarchySelection)); err != nil {
+		log.Printf("[WARN] Error setting 'hierarchy_selection' field for %q: %s", d.Id(), err)
+	}
+}
+
+func createCloudAccountAzure(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	if d.Get("name").(string) == "" {
+		if v, ok := d.GetOk("name_prefix"); ok {
+			d.Set("name", resource.PrefixedUniqueId(v.(string)))
+		} else {
+			d.Set("name", resource.UniqueId())
+		}
+	}
+
+	isOrg := isAzureOrgAccount(d)
+
+	if isOrg && d.Get("account_type").(string) == accountTypeTenant && !d.Get("root_sync_enabled").(bool) {
+		return fmt.Errorf("root_sync_enabled is required when account_type is %q", accountTypeTenant)
+	}
+
+	var (
+		name string
+		err  error
+	)
+
+	if isOrg {
+		obj := parseCloudAccountAzureOrg(d, "")
+		name = obj.Account.Name
+		err = org.Create(client, obj)
+	} else {
+		obj := parseCloudAccountAzure(d, "")
+		name = obj.Account.Name
+		err = account.Create(client, obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	var id string
+	if isOrg {
+		id, err = org.Identify(client, org.TypeAzureOrg, name)
+	} else {
+		id, err = account.Identify(client, account.TypeAzure, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(account.TypeAzure, id))
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAzure, id, isOrg, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAzure(d, meta)
+}
+
+func readCloudAccountAzure(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	if isAzureOrgAccount(d) {
+		obj, err := org.Get(client, org.TypeAzureOrg, id)
+		if err != nil {
+			if err == pc.ObjectNotFoundError {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+
+		count := 0
+		if list, err := org.List(client); err == nil {
+			for _, o := range list {
+				if o.CloudType == org.TypeAzureOrg && o.AccountId == id {
+					count = o.NumberOfChildAccounts
+					break
+				}
+			}
+		}
+
+		saveCloudAccountAzureOrg(d, obj.(org.AzureOrg), count)
+		return nil
+	}
+
+	obj, err := account.Get(client, account.TypeAzure, id)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveCloudAccountAzure(d, obj.(account.Azure))
+
+	return nil
+}
+
+func updateCloudAccountAzure(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	_, id := IdToTwoStrings(d.Id())
+	isOrg := isAzureOrgAccount(d)
+
+	if isOrg && d.Get("account_type").(string) == accountTypeTenant && !d.Get("root_sync_enabled").(bool) {
+		return fmt.Errorf("root_sync_enabled is required when account_type is %q", accountTypeTenant)
+	}
+
+	var err error
+	if isOrg {
+		err = org.Update(client, parseCloudAccountAzureOrg(d, id))
+	} else {
+		err = account.Update(client, parseCloudAccountAzure(d, id))
+	}
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeAzure, id, isOrg, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountAzure(d, meta)
+}
+
+func deleteCloudAccountAzure(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	var err error
+	if isAzureOrgAccount(d) {
+		err = org.Delete(client, org.TypeAzureOrg, id)
+	} else {
+		err = account.Delete(client, account.TypeAzure, id)
+	}
+	if err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
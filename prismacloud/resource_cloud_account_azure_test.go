@@ -0,0 +1,269 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+)
+
+func TestResourceCloudAccountAzureSchema(t *testing.T) {
+	if err := resourceCloudAccountAzure().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
+
+func TestIsAzureOrgAccount(t *testing.T) {
+	cases := []struct {
+		accountType string
+		want        bool
+	}{
+		{accountTypeAccount, false},
+		{accountTypeTenant, true},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceCloudAccountAzure().Schema, map[string]interface{}{
+			"account_type": c.accountType,
+		})
+
+		if got := isAzureOrgAccount(d); got != c.want {
+			t.Errorf("account_type %q: expected %v, got %v", c.accountType, c.want, got)
+		}
+	}
+}
+
+func azureTestRaw(accountType string, extra map[string]interface{}) map[string]interface{} {
+	raw := map[string]interface{}{
+		"account_type":    accountType,
+		"name":            "my-account",
+		"client_id":       "client-1",
+		"key":             "shh",
+		"tenant_id":       "tenant-1",
+		"group_ids":       []interface{}{"g1"},
+		"skip_validation": true,
+	}
+	if accountType == accountTypeTenant {
+		raw["service_principal_id"] = "sp-1"
+		raw["root_sync_enabled"] = true
+	}
+	for k, v := range extra {
+		raw[k] = v
+	}
+	return raw
+}
+
+// TestCreateCloudAccountAzureDispatch proves, via the request body actually
+// sent over the wire, that a tenant account_type is routed through
+// org.Create (whose body carries org-only fields like rootSyncEnabled)
+// while a plain account_type goes through account.Create (whose body does
+// not).
+func TestCreateCloudAccountAzureDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, false},
+		{"tenant account", accountTypeTenant, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var createBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "POST" && r.URL.Path == "/cloud/azure":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &createBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/name":
+					json.NewEncoder(w).Encode([]map[string]string{
+						{"name": "my-account", "cloudType": account.TypeAzure, "id": "acct-1"},
+					})
+				case r.URL.Path == "/cloud/azure/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "acct-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAzure().Schema, azureTestRaw(c.accountType, nil))
+
+			if err := createCloudAccountAzure(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := createBody["rootSyncEnabled"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected rootSyncEnabled present=%v in create body, got %v", c.wantOrgBody, createBody)
+			}
+		})
+	}
+}
+
+// TestReadCloudAccountAzureDispatch proves that a tenant account_type reads
+// through org.Get (which decodes org-only fields like root_sync_enabled)
+// while a plain account_type reads through account.Get (which does not).
+func TestReadCloudAccountAzureDispatch(t *testing.T) {
+	cases := []struct {
+		name               string
+		accountType        string
+		wantRootSyncEnable bool
+		wantMemberCount    int
+	}{
+		{"plain account", accountTypeAccount, false, 0},
+		{"tenant account", accountTypeTenant, true, 4},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.URL.Path == "/cloud/azure/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "acct-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+						"rootSyncEnabled": true,
+					})
+				case r.URL.Path == "/cloud":
+					json.NewEncoder(w).Encode([]map[string]interface{}{
+						{"cloudType": account.TypeAzure, "accountId": "acct-1", "numberOfChildAccounts": c.wantMemberCount},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAzure().Schema, map[string]interface{}{
+				"account_type": c.accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeAzure, "acct-1"))
+
+			if err := readCloudAccountAzure(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("root_sync_enabled").(bool); got != c.wantRootSyncEnable {
+				t.Errorf("expected root_sync_enabled %v, got %v", c.wantRootSyncEnable, got)
+			}
+			if got := d.Get("member_account_count").(int); got != c.wantMemberCount {
+				t.Errorf("expected member_account_count %d, got %d", c.wantMemberCount, got)
+			}
+		})
+	}
+}
+
+// TestUpdateCloudAccountAzureDispatch mirrors
+// TestCreateCloudAccountAzureDispatch but for the update path (org.Update vs
+// account.Update).
+func TestUpdateCloudAccountAzureDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, false},
+		{"tenant account", accountTypeTenant, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var updateBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "PUT" && r.URL.Path == "/cloud/azure/acct-1":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &updateBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/azure/acct-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "acct-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAzure().Schema, azureTestRaw(c.accountType, nil))
+			d.SetId(TwoStringsToId(account.TypeAzure, "acct-1"))
+
+			if err := updateCloudAccountAzure(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := updateBody["rootSyncEnabled"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected rootSyncEnabled present=%v in update body, got %v", c.wantOrgBody, updateBody)
+			}
+		})
+	}
+}
+
+// TestDeleteCloudAccountAzureDispatch confirms deletion succeeds for both
+// account types. org.Delete and account.Delete issue an identical DELETE to
+// the same path with no body, so the wire cannot distinguish which one ran;
+// this only proves deleteCloudAccountAzure doesn't error either way.
+func TestDeleteCloudAccountAzureDispatch(t *testing.T) {
+	for _, accountType := range []string{accountTypeAccount, accountTypeTenant} {
+		t.Run(accountType, func(t *testing.T) {
+			var sawDelete bool
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "DELETE" && r.URL.Path == "/cloud/azure/acct-1":
+					sawDelete = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountAzure().Schema, map[string]interface{}{
+				"account_type": accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeAzure, "acct-1"))
+
+			if err := deleteCloudAccountAzure(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !sawDelete {
+				t.Error("expected a DELETE request to /cloud/azure/acct-1")
+			}
+			if d.Id() != "" {
+				t.Errorf("expected id to be cleared, got %q", d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account/org"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceCloudAccountGcp() *schema.Resource {
+	return &schema.Resource{
+		Create: createCloudAccountGcp,
+		Read:   readCloudAccountGcp,
+		Update: updateCloudAccountGcp,
+		Delete: deleteCloudAccountGcp,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: cloudAccountImporter(account.TypeGcp),
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP project ID",
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     accountTypeAccount,
+				Description: "Account onboarding type: account, organization, or masterServiceAccount",
+			},
+			"organization_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the GCP organization, required when account_type is organization",
+			},
+			"hierarchy_selection":  hierarchySelectionSchema(),
+			"member_account_count": memberAccountCountSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not the account is enabled",
+				Default:     true,
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of account IDs to which you are assigning this account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				Description:   "Name to be used for the account on the Prisma Cloud platform (must be unique)",
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "Creates a unique name beginning with the specified prefix",
+			},
+			"compression_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable flow log compression",
+			},
+			"dataflow_enabled_project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCP project for flow log compression",
+			},
+			"flow_log_storage_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCP flow logs storage bucket",
+			},
+			// Use a json string until this feature is added:
+			// https://github.com/hashicorp/terraform-plugin-sdk/issues/248
+			"credentials_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Content of the JSON credentials file",
+				Sensitive:        true,
+				DiffSuppressFunc: gcpCredentialsMatch,
+			},
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip waiting for the account's onboarding validation to complete",
+			},
+		},
+	}
+}
+
+func isGcpOrgAccount(d *schema.ResourceData) bool {
+	return d.Get("account_type").(string) != accountTypeAccount
+}
+
+func parseCloudAccountGcp(d *schema.ResourceData, id string) account.Gcp {
+	var creds account.GcpCredentials
+	_ = json.Unmarshal([]byte(d.Get("credentials_json").(string)), &creds)
+
+	return account.Gcp{
+		Account: account.CloudAccount{
+			AccountId:   id,
+			Enabled:     d.Get("enabled").(bool),
+			GroupIds:    ListToStringSlice(d.Get("group_ids").([]interface{})),
+			Name:        d.Get("name").(string),
+			AccountType: d.Get("account_type").(string),
+		},
+		CompressionEnabled:     d.Get("compression_enabled").(bool),
+		DataflowEnabledProject: d.Get("dataflow_enabled_project").(string),
+		FlowLogStorageBucket:   d.Get("flow_log_storage_bucket").(string),
+		Credentials:            creds,
+	}
+}
+
+func parseCloudAccountGcpOrg(d *schema.ResourceData, id string) org.GcpOrg {
+	var creds org.GcpOrgCredentials
+	_ = json.Unmarshal([]byte(d.Get("credentials_json").(string)), &creds)
+
+	return org.GcpOrg{
+		Account: org.GcpCloudAccount{
+			AccountId:   id,
+			Enabled:     d.Get("enabled").(bool),
+			GroupIds:    ListToStringSlice(d.Get("group_ids").([]interface{})),
+			Name:        d.Get("name").(string),
+			AccountType: d.Get("account_type").(string),
+		},
+		CompressionEnabled:     d.Get("compression_enabled").(bool),
+		DataflowEnabledProject: d.Get("dataflow_enabled_project").(string),
+		FlowLogStorageBucket:   d.Get("flow_log_storage_bucket").(string),
+		OrganizationName:       d.Get("organization_name").(string),
+		Credentials:            creds,
+		HierarchySelection:     parseHierarchySelection(d.Get("hierarchy_selection").([]interface{})),
+	}
+}
+
+func saveCloudAccountGcp(d *schema.ResourceData, obj account.Gcp) {
+	b, _ := json.Marshal(obj.Credentials)
+
+	d.Set("account_id", obj.Account.AccountId)
+	d.Set("enabled", obj.Account.Enabled)
+	if err := d.Set("group_ids", obj.Account.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Account.Name)
+	d.Set("account_type", obj.Account.AccountType)
+	d.Set("compression_enabled", obj.CompressionEnabled)
+	d.Set("dataflow_enabled_project", obj.DataflowEnabledProject)
+	d.Set("flow_log_storage_bucket", obj.FlowLogStorageBucket)
+	d.Set("credentials_json", string(b))
+}
+
+func saveCloudAccountGcpOrg(d *schema.ResourceData, obj org.GcpOrg, memberAccountCount int) {
+	b, _ := json.Marshal(obj.Credentials)
+
+	d.Set("account_id", obj.Account.AccountId)
+	d.Set("enabled", obj.Account.Enabled)
+	if err := d.Set("group_ids", obj.Account.GroupIds); err != nil {
+		log.Printf("[WARN] Error setting 'group_ids' field for %q: %s", d.Id(), err)
+	}
+	d.Set("name", obj.Account.Name)
+	d.Set("account_type", obj.Account.AccountType)
+	d.Set("compression_enabled", obj.CompressionEnabled)
+	d.Set("dataflow_enabled_project", obj.DataflowEnabledProject)
+	d.Set("flow_log_storage_bucket", obj.FlowLogStorageBucket)
+	d.Set("credentials_json", string(b))
+	d.Set("organization_name", obj.OrganizationName)
+	d.Set("member_account_count", memberAccountCount)
+	if err := d.Set("hierarchy_selection", saveHierarchySelection(obj.HierarchySelection)); err != nil {
+		log.Printf("[WARN] Error setting 'hierarchy_selection' field for %q: %s", d.Id(), err)
+	}
+}
+
+func createCloudAccountGcp(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	if d.Get("name").(string) == "" {
+		if v, ok := d.GetOk("name_prefix"); ok {
+			d.Set("name", resource.PrefixedUniqueId(v.(string)))
+		} else {
+			d.Set("name", resource.UniqueId())
+		}
+	}
+
+	isOrg := isGcpOrgAccount(d)
+
+	if isOrg && d.Get("account_type").(string) == accountTypeOrganization && d.Get("organization_name").(string) == "" {
+		return fmt.Errorf("organization_name is required when account_type is %q", accountTypeOrganization)
+	}
+
+	var (
+		name string
+		err  error
+	)
+
+	if isOrg {
+		obj := parseCloudAccountGcpOrg(d, "")
+		name = obj.Account.Name
+		err = org.Create(client, obj)
+	} else {
+		obj := parseCloudAccountGcp(d, "")
+		name = obj.Account.Name
+		err = account.Create(client, obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	var id string
+	if isOrg {
+		id, err = org.Identify(client, org.TypeGcpOrg, name)
+	} else {
+		id, err = account.Identify(client, account.TypeGcp, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(account.TypeGcp, id))
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeGcp, id, isOrg, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountGcp(d, meta)
+}
+
+func readCloudAccountGcp(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	if isGcpOrgAccount(d) {
+		obj, err := org.Get(client, org.TypeGcpOrg, id)
+		if err != nil {
+			if err == pc.ObjectNotFoundError {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+
+		count := 0
+		if list, err := org.List(client); err == nil {
+			for _, o := range list {
+				if o.CloudType == org.TypeGcpOrg && o.AccountId == id {
+					count = o.NumberOfChildAccounts
+					break
+				}
+			}
+		}
+
+		saveCloudAccountGcpOrg(d, obj.(org.GcpOrg), count)
+		return nil
+	}
+
+	obj, err := account.Get(client, account.TypeGcp, id)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveCloudAccountGcp(d, obj.(account.Gcp))
+
+	return nil
+}
+
+func updateCloudAccountGcp(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+
+	_, id := IdToTwoStrings(d.Id())
+	isOrg := isGcpOrgAccount(d)
+
+	if isOrg && d.Get("account_type").(string) == accountTypeOrganization && d.Get("organization_name").(string) == "" {
+		return fmt.Errorf("organization_name is required when account_type is %q", accountTypeOrganization)
+	}
+
+	var err error
+	if isOrg {
+		err = org.Update(client, parseCloudAccountGcpOrg(d, id))
+	} else {
+		err = account.Update(client, parseCloudAccountGcp(d, id))
+	}
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("skip_validation").(bool) {
+		if err := waitForCloudAccountStatus(client, account.TypeGcp, id, isOrg, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return readCloudAccountGcp(d, meta)
+}
+
+func deleteCloudAccountGcp(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pc.Client)
+	_, id := IdToTwoStrings(d.Id())
+
+	var err error
+	if isGcpOrgAccount(d) {
+		err = org.Delete(client, org.TypeGcpOrg, id)
+	} else {
+		err = account.Delete(client, account.TypeGcp, id)
+	}
+	if err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,263 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+)
+
+func TestResourceCloudAccountGcpSchema(t *testing.T) {
+	if err := resourceCloudAccountGcp().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
+
+func TestIsGcpOrgAccount(t *testing.T) {
+	cases := []struct {
+		accountType string
+		want        bool
+	}{
+		{accountTypeAccount, false},
+		{accountTypeOrganization, true},
+		{accountTypeMasterServiceAccount, true},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceCloudAccountGcp().Schema, map[string]interface{}{
+			"account_type": c.accountType,
+		})
+
+		if got := isGcpOrgAccount(d); got != c.want {
+			t.Errorf("account_type %q: expected %v, got %v", c.accountType, c.want, got)
+		}
+	}
+}
+
+func gcpTestRaw(accountType string) map[string]interface{} {
+	raw := map[string]interface{}{
+		"account_type":     accountType,
+		"name":             "my-account",
+		"account_id":       "proj-1",
+		"group_ids":        []interface{}{"g1"},
+		"credentials_json": `{"type":"service_account"}`,
+		"skip_validation":  true,
+	}
+	if accountType == accountTypeOrganization {
+		raw["organization_name"] = "my-org"
+	}
+	return raw
+}
+
+// TestCreateCloudAccountGcpDispatch proves, via the request body actually
+// sent over the wire, that an organization account_type is routed through
+// org.Create (whose body carries the org-only organizationName field) while
+// a plain account_type goes through account.Create (whose body does not).
+func TestCreateCloudAccountGcpDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, false},
+		{"organization account", accountTypeOrganization, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var createBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "POST" && r.URL.Path == "/cloud/gcp":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &createBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/name":
+					json.NewEncoder(w).Encode([]map[string]string{
+						{"name": "my-account", "cloudType": account.TypeGcp, "id": "proj-1"},
+					})
+				case r.URL.Path == "/cloud/gcp/proj-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "proj-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountGcp().Schema, gcpTestRaw(c.accountType))
+
+			if err := createCloudAccountGcp(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := createBody["organizationName"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected organizationName present=%v in create body, got %v", c.wantOrgBody, createBody)
+			}
+		})
+	}
+}
+
+// TestReadCloudAccountGcpDispatch proves that an org account_type reads
+// through org.Get (which decodes the org-only organization_name field)
+// while a plain account_type reads through account.Get (which does not).
+func TestReadCloudAccountGcpDispatch(t *testing.T) {
+	cases := []struct {
+		name            string
+		accountType     string
+		wantOrgName     string
+		wantMemberCount int
+	}{
+		{"plain account", accountTypeAccount, "", 0},
+		{"organization account", accountTypeOrganization, "my-org", 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.URL.Path == "/cloud/gcp/proj-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "proj-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+						"organizationName": "my-org",
+					})
+				case r.URL.Path == "/cloud":
+					json.NewEncoder(w).Encode([]map[string]interface{}{
+						{"cloudType": account.TypeGcp, "accountId": "proj-1", "numberOfChildAccounts": c.wantMemberCount},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountGcp().Schema, map[string]interface{}{
+				"account_type": c.accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeGcp, "proj-1"))
+
+			if err := readCloudAccountGcp(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("organization_name").(string); got != c.wantOrgName {
+				t.Errorf("expected organization_name %q, got %q", c.wantOrgName, got)
+			}
+			if got := d.Get("member_account_count").(int); got != c.wantMemberCount {
+				t.Errorf("expected member_account_count %d, got %d", c.wantMemberCount, got)
+			}
+		})
+	}
+}
+
+// TestUpdateCloudAccountGcpDispatch mirrors TestCreateCloudAccountGcpDispatch
+// but for the update path (org.Update vs account.Update).
+func TestUpdateCloudAccountGcpDispatch(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountType string
+		wantOrgBody bool
+	}{
+		{"plain account", accountTypeAccount, false},
+		{"organization account", accountTypeOrganization, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var updateBody map[string]interface{}
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "PUT" && r.URL.Path == "/cloud/gcp/proj-1":
+					b, _ := ioutil.ReadAll(r.Body)
+					json.Unmarshal(b, &updateBody)
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/cloud/gcp/proj-1":
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"cloudAccount": map[string]interface{}{
+							"accountId":   "proj-1",
+							"name":        "my-account",
+							"accountType": c.accountType,
+						},
+					})
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountGcp().Schema, gcpTestRaw(c.accountType))
+			d.SetId(TwoStringsToId(account.TypeGcp, "proj-1"))
+
+			if err := updateCloudAccountGcp(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, hasOrgField := updateBody["organizationName"]
+			if hasOrgField != c.wantOrgBody {
+				t.Errorf("expected organizationName present=%v in update body, got %v", c.wantOrgBody, updateBody)
+			}
+		})
+	}
+}
+
+// TestDeleteCloudAccountGcpDispatch confirms deletion succeeds for both
+// account types. org.Delete and account.Delete issue an identical DELETE to
+// the same path with no body, so the wire cannot distinguish which one ran;
+// this only proves deleteCloudAccountGcp doesn't error either way.
+func TestDeleteCloudAccountGcpDispatch(t *testing.T) {
+	for _, accountType := range []string{accountTypeAccount, accountTypeOrganization} {
+		t.Run(accountType, func(t *testing.T) {
+			var sawDelete bool
+
+			client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/login":
+					json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+				case r.Method == "DELETE" && r.URL.Path == "/cloud/gcp/proj-1":
+					sawDelete = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCloudAccountGcp().Schema, map[string]interface{}{
+				"account_type": accountType,
+			})
+			d.SetId(TwoStringsToId(account.TypeGcp, "proj-1"))
+
+			if err := deleteCloudAccountGcp(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !sawDelete {
+				t.Error("expected a DELETE request to /cloud/gcp/proj-1")
+			}
+			if d.Id() != "" {
+				t.Errorf("expected id to be cleared, got %q", d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package prismacloud
+
+import (
+	"testing"
+)
+
+func TestResourceCloudAccountSchema(t *testing.T) {
+	if err := resourceCloudAccount().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema is invalid: %s", err)
+	}
+}
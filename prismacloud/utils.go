@@ -0,0 +1,49 @@
+package prismacloud
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// IdSeparator is used to join together multiple strings into a single
+// resource ID (and to split them back apart again).
+const IdSeparator = ":"
+
+// TwoStringsToId combines two strings into a single resource ID.
+func TwoStringsToId(a, b string) string {
+	return strings.Join([]string{a, b}, IdSeparator)
+}
+
+// IdToTwoStrings splits a resource ID produced by TwoStringsToId back into
+// its two components.
+func IdToTwoStrings(id string) (string, string) {
+	tok := strings.Split(id, IdSeparator)
+	if len(tok) != 2 {
+		return "", ""
+	}
+
+	return tok[0], tok[1]
+}
+
+// ResourceDataInterfaceMap returns the single nested block stored at key as
+// a map, or an empty map if the block isn't present.
+func ResourceDataInterfaceMap(d *schema.ResourceData, key string) map[string]interface{} {
+	lst := d.Get(key).([]interface{})
+	if len(lst) == 0 || lst[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	return lst[0].(map[string]interface{})
+}
+
+// ListToStringSlice converts a list of interface{} values (as returned by
+// ResourceData.Get for a TypeList of TypeString) into a []string.
+func ListToStringSlice(list []interface{}) []string {
+	ans := make([]string, 0, len(list))
+	for _, i := range list {
+		ans = append(ans, i.(string))
+	}
+
+	return ans
+}
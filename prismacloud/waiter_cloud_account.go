@@ -0,0 +1,95 @@
+package prismacloud
+
+import (
+	"fmt"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-go"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account/org"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	cloudAccountStatusPending = "pending"
+	cloudAccountStatusValid   = "valid"
+	cloudAccountStatusInvalid = "invalid"
+	cloudAccountStatusWarning = "warning"
+)
+
+// cloudAccountStatus looks up the onboarding/validation status that the
+// platform reports for an account or org-level account, via the account.List
+// and org.List listing endpoints.
+func cloudAccountStatus(client *pc.Client, cloudType, id string, isOrg bool) (string, error) {
+	if isOrg {
+		list, err := org.List(client)
+		if err != nil {
+			return "", err
+		}
+
+		for _, o := range list {
+			if o.CloudType == cloudType && o.AccountId == id {
+				return o.Status, nil
+			}
+		}
+
+		return "", pc.ObjectNotFoundError
+	}
+
+	list, err := account.List(client)
+	if err != nil {
+		return "", err
+	}
+
+	for _, o := range list {
+		if o.CloudType == cloudType && o.AccountId == id {
+			return o.Status, nil
+		}
+	}
+
+	return "", pc.ObjectNotFoundError
+}
+
+// CloudAccountStateRefreshFunc polls the asynchronous onboarding/validation
+// status of a cloud account for use with a resource.StateChangeConf.
+func CloudAccountStateRefreshFunc(client *pc.Client, cloudType, id string, isOrg bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := cloudAccountStatus(client, cloudType, id, isOrg)
+		if err != nil {
+			if err == pc.ObjectNotFoundError {
+				// The account/org was just created and hasn't shown up in
+				// account.List/org.List yet. Report it as "not found" rather
+				// than as an error, so StateChangeConf's NotFoundChecks
+				// tolerance covers this startup race instead of
+				// WaitForState aborting immediately.
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		if status == cloudAccountStatusInvalid {
+			// account.Account/org.OrgAccount don't expose a validation
+			// message, so this is as actionable an error as the SDK lets
+			// us build; it at least identifies which account/cloud failed.
+			return status, status, fmt.Errorf("%s cloud account %q failed validation (status: %s)", cloudType, id, status)
+		}
+
+		return status, status, nil
+	}
+}
+
+// waitForCloudAccountStatus blocks until the account's onboarding validation
+// reaches a terminal state, or the given timeout elapses.
+func waitForCloudAccountStatus(client *pc.Client, cloudType, id string, isOrg bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudAccountStatusPending},
+		Target:  []string{cloudAccountStatusValid, cloudAccountStatusWarning},
+		Refresh: CloudAccountStateRefreshFunc(client, cloudType, id, isOrg),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
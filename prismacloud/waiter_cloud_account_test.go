@@ -0,0 +1,67 @@
+package prismacloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/paloaltonetworks/prisma-cloud-go/cloud/account"
+)
+
+func TestCloudAccountStateRefreshFuncNotFoundIsNotAnError(t *testing.T) {
+	client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+		case r.URL.Path == "/cloud":
+			// account.List returns the listing the account hasn't shown up in yet.
+			json.NewEncoder(w).Encode([]map[string]string{})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+
+	refresh := CloudAccountStateRefreshFunc(client, account.TypeAws, "not-listed-yet", false)
+
+	result, state, err := refresh()
+	if err != nil {
+		t.Fatalf("expected a nil error for a not-yet-listed account, got %s", err)
+	}
+	if result != nil || state != "" {
+		t.Errorf("expected (nil, \"\"), got (%v, %q)", result, state)
+	}
+}
+
+func TestCloudAccountStateRefreshFuncInvalid(t *testing.T) {
+	client, server := newImporterTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/login":
+			json.NewEncoder(w).Encode(map[string]string{"token": "testtoken"})
+		case r.URL.Path == "/cloud":
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"cloudType": account.TypeAws, "accountId": "bad-creds", "status": cloudAccountStatusInvalid},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+
+	refresh := CloudAccountStateRefreshFunc(client, account.TypeAws, "bad-creds", false)
+
+	_, state, err := refresh()
+	if state != cloudAccountStatusInvalid {
+		t.Errorf("expected state %q, got %q", cloudAccountStatusInvalid, state)
+	}
+	if err == nil {
+		t.Fatal("expected a non-nil error for an invalid account")
+	}
+	got := err.Error()
+	for _, want := range []string{account.TypeAws, "bad-creds", cloudAccountStatusInvalid} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected error %q to mention %q", got, want)
+		}
+	}
+}